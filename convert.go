@@ -0,0 +1,153 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NewFromString parses s into a Decimal. s may have an optional leading
+// sign, an integer part, a fractional part, and scientific notation
+// (e.g. "1.23e-4").
+func NewFromString(s string) (*Decimal, error) {
+	orig := s
+	if s == "" {
+		return nil, fmt.Errorf("decimal: cannot parse %q: empty string", orig)
+	}
+
+	neg := false
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		neg = true
+		s = s[1:]
+	}
+
+	exp := int32(0)
+	if idx := strings.IndexAny(s, "eE"); idx >= 0 {
+		e, err := strconv.ParseInt(s[idx+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("decimal: cannot parse %q: invalid exponent", orig)
+		}
+		exp = int32(e)
+		s = s[:idx]
+	}
+
+	intPart, fracPart := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		intPart = s[:idx]
+		fracPart = s[idx+1:]
+	}
+
+	digits := intPart + fracPart
+	if digits == "" || !isDigitString(digits) {
+		return nil, fmt.Errorf("decimal: cannot parse %q: invalid digits", orig)
+	}
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("decimal: cannot parse %q", orig)
+	}
+	if neg {
+		value.Neg(value)
+	}
+
+	return newDecimal(value, exp-int32(len(fracPart))), nil
+}
+
+func isDigitString(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns d in plain decimal notation, e.g. "-1.230".
+func (d *Decimal) String() string {
+	return d.string(false)
+}
+
+// MarshalJSON implements json.Marshaler, emitting a bare numeric
+// literal. Use MarshalJSONString for a quoted string instead, e.g. for
+// JavaScript consumers that lose precision on large bare numbers.
+func (d *Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MarshalJSONString returns d as a quoted JSON string rather than a
+// bare numeric literal.
+func (d *Decimal) MarshalJSONString() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting both bare
+// numeric literals and quoted strings.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), `"`)
+	if str == "null" {
+		return nil
+	}
+
+	parsed, err := NewFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d *Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := NewFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting []byte, string, int64, and
+// float64 source values.
+func (d *Decimal) Scan(value interface{}) error {
+	var parsed *Decimal
+	var err error
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		parsed, err = NewFromString(string(v))
+	case string:
+		parsed, err = NewFromString(v)
+	case int64:
+		parsed = New(v, 0)
+	case float64:
+		parsed, err = NewFromString(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return fmt.Errorf("decimal: unsupported Scan type %T", value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (d *Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
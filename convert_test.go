@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewFromString(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"123", "123"},
+		{"-123", "-123"},
+		{"1.23", "1.23"},
+		{"-1.23", "-1.23"},
+		{".5", "0.5"},
+		{"1.23e-4", "0.000123"},
+		{"-1.23E2", "-123"},
+	}
+
+	for _, c := range cases {
+		d, err := NewFromString(c.in)
+		if err != nil {
+			t.Fatalf("NewFromString(%q) error: %v", c.in, err)
+		}
+		if got := d.String(); got != c.want {
+			t.Errorf("NewFromString(%q).String() = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestNewFromStringInvalid(t *testing.T) {
+	for _, in := range []string{"", "+", "abc", "1.2.3", "1e"} {
+		if _, err := NewFromString(in); err == nil {
+			t.Errorf("NewFromString(%q) expected an error", in)
+		}
+	}
+}
+
+type wrapper struct {
+	Amount *Decimal `json:"amount"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	d, err := NewFromString("-1.23e-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(wrapper{Amount: d})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount":-0.0123}` {
+		t.Errorf("MarshalJSON bare number = %s", b)
+	}
+
+	var w wrapper
+	if err := json.Unmarshal(b, &w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Amount.String() != "-0.0123" {
+		t.Errorf("round-tripped value = %s, want -0.0123", w.Amount.String())
+	}
+}
+
+func TestMarshalJSONString(t *testing.T) {
+	d := New(123, -2)
+	b, err := d.MarshalJSONString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"1.23"` {
+		t.Errorf("MarshalJSONString() = %s, want \"1.23\"", b)
+	}
+}
+
+func TestTextMarshaling(t *testing.T) {
+	d := New(123, -2)
+	text, err := d.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "1.23" {
+		t.Errorf("MarshalText = %s, want 1.23", text)
+	}
+
+	var got Decimal
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "1.23" {
+		t.Errorf("UnmarshalText round-trip = %s, want 1.23", got.String())
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want string
+	}{
+		{[]byte("1.23"), "1.23"},
+		{"1.23", "1.23"},
+		{int64(42), "42"},
+		{float64(1.5), "1.5"},
+	}
+
+	for _, c := range cases {
+		var d Decimal
+		if err := d.Scan(c.in); err != nil {
+			t.Fatalf("Scan(%v) error: %v", c.in, err)
+		}
+		if got := d.String(); got != c.want {
+			t.Errorf("Scan(%v) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestScanUnsupportedType(t *testing.T) {
+	var d Decimal
+	if err := d.Scan(true); err == nil {
+		t.Error("Scan(bool) expected an error")
+	}
+}
+
+func TestValue(t *testing.T) {
+	d := New(123, -2)
+	v, err := d.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "1.23" {
+		t.Errorf("Value() = %v, want 1.23", v)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"strings"
 )
 
@@ -14,18 +15,71 @@ var (
 	tenInt  = big.NewInt(10)
 )
 
-// Decimal ...
+// pow10 holds 10^0..10^18, the largest powers of ten that still fit in
+// an int64, for the coef fast path.
+var pow10 = [...]int64{
+	1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000,
+	1000000000, 10000000000, 100000000000, 1000000000000,
+	10000000000000, 100000000000000, 1000000000000000,
+	10000000000000000, 100000000000000000, 1000000000000000000,
+}
+
+// Decimal is a fixed-point decimal number: coef * 10^exp. Values whose
+// coefficient fits in an int64 are held in coef with big left nil,
+// avoiding a big.Int allocation; coef is promoted to big only once an
+// operation would overflow it.
 type Decimal struct {
-	value *big.Int
-	exp   int32
+	coef int64
+	big  *big.Int
+	exp  int32
 }
 
 // New ...
 func New(value int64, exp int32) *Decimal {
 	return &Decimal{
-		value: big.NewInt(value),
-		exp:   exp,
+		coef: value,
+		exp:  exp,
+	}
+}
+
+// newDecimal wraps a big.Int result, demoting it back to the coef fast
+// path when it fits in an int64.
+func newDecimal(value *big.Int, exp int32) *Decimal {
+	if value.IsInt64() {
+		return &Decimal{coef: value.Int64(), exp: exp}
+	}
+	return &Decimal{big: value, exp: exp}
+}
+
+// isSmall reports whether d is on the coef fast path.
+func (d *Decimal) isSmall() bool {
+	return d.big == nil
+}
+
+// toBig returns d's coefficient as a big.Int. On the fast path this
+// allocates a fresh big.Int; callers on a hot path should prefer the
+// coef fields directly via isSmall.
+func (d *Decimal) toBig() *big.Int {
+	if d.big != nil {
+		return d.big
+	}
+	return big.NewInt(d.coef)
+}
+
+// sign reports the sign of d's coefficient without allocating on the
+// fast path.
+func (d *Decimal) sign() int {
+	if d.isSmall() {
+		switch {
+		case d.coef < 0:
+			return -1
+		case d.coef > 0:
+			return 1
+		default:
+			return 0
+		}
 	}
+	return d.big.Sign()
 }
 
 // Add ...
@@ -34,10 +88,16 @@ func (d *Decimal) Add(d2 *Decimal) *Decimal {
 	rd := d.rescale(baseScale)
 	rd2 := d2.rescale(baseScale)
 
-	d3Value := new(big.Int).Add(rd.value, rd2.value)
+	if rd.isSmall() && rd2.isSmall() {
+		if sum, ok := addInt64(rd.coef, rd2.coef); ok {
+			return &Decimal{coef: sum, exp: baseScale}
+		}
+	}
+
+	d3Value := new(big.Int).Add(rd.toBig(), rd2.toBig())
 	return &Decimal{
-		value: d3Value,
-		exp:   baseScale,
+		big: d3Value,
+		exp: baseScale,
 	}
 }
 
@@ -47,10 +107,16 @@ func (d *Decimal) Sub(d2 *Decimal) *Decimal {
 	rd := d.rescale(baseScale)
 	rd2 := d2.rescale(baseScale)
 
-	d3Value := new(big.Int).Sub(rd.value, rd2.value)
+	if rd.isSmall() && rd2.isSmall() {
+		if diff, ok := subInt64(rd.coef, rd2.coef); ok {
+			return &Decimal{coef: diff, exp: baseScale}
+		}
+	}
+
+	d3Value := new(big.Int).Sub(rd.toBig(), rd2.toBig())
 	return &Decimal{
-		value: d3Value,
-		exp:   baseScale,
+		big: d3Value,
+		exp: baseScale,
 	}
 }
 
@@ -63,10 +129,16 @@ func (d *Decimal) Mul(d2 *Decimal) *Decimal {
 		panic(fmt.Sprintf("exponent %v overflows an int32!", expInt64))
 	}
 
-	d3Value := new(big.Int).Mul(d.value, d2.value)
+	if d.isSmall() && d2.isSmall() {
+		if product, ok := mulInt64(d.coef, d2.coef); ok {
+			return &Decimal{coef: product, exp: int32(expInt64)}
+		}
+	}
+
+	d3Value := new(big.Int).Mul(d.toBig(), d2.toBig())
 	return &Decimal{
-		value: d3Value,
-		exp:   int32(expInt64),
+		big: d3Value,
+		exp: int32(expInt64),
 	}
 }
 
@@ -76,72 +148,133 @@ func (d *Decimal) StringFixedBank(places int32) string {
 	return rounded.string(false)
 }
 
-// RoundBank ...
+// RoundBank rounds d to the given number of places using banker's
+// rounding (round half to even).
 func (d *Decimal) RoundBank(places int32) *Decimal {
-
-	round := d.Round(places)
-	remainder := d.Sub(round).Abs()
-
-	half := New(5, -places-1)
-	if remainder.Cmp(half) == 0 && round.value.Bit(0) != 0 {
-		if round.value.Sign() < 0 {
-			round.value.Add(round.value, oneInt)
-		} else {
-			round.value.Sub(round.value, oneInt)
-		}
-	}
-
-	return round
+	return d.RoundMode(places, ToNearestEven)
 }
 
-// Round ...
+// Round rounds d to the given number of places, rounding half away
+// from zero.
 func (d *Decimal) Round(places int32) *Decimal {
-	// truncate to places + 1
+	return d.RoundMode(places, ToNearestAway)
+}
+
+// RoundMode rounds d to the given number of places using mode to
+// resolve how the discarded remainder is applied.
+func (d *Decimal) RoundMode(places int32, mode RoundingMode) *Decimal {
+	// truncate to places + 1, so the discarded digit ends up as the
+	// last digit of ret
 	ret := d.rescale(-places - 1)
 
-	// add sign(d) * 0.5
-	if ret.value.Sign() < 0 {
-		ret.value.Sub(ret.value, fiveInt)
-	} else {
-		ret.value.Add(ret.value, fiveInt)
+	// sticky reports whether the truncation also dropped nonzero digits
+	// beyond the last digit of ret
+	sticky := ret.Cmp(d) != 0
+
+	neg := d.sign() < 0
+	abs := new(big.Int).Abs(ret.toBig())
+
+	quo, last := new(big.Int).QuoRem(abs, tenInt, new(big.Int))
+
+	roundUp := false
+	switch mode {
+	case ToZero:
+		roundUp = false
+	case AwayFromZero:
+		roundUp = last.Sign() != 0 || sticky
+	case ToPositiveInf:
+		roundUp = !neg && (last.Sign() != 0 || sticky)
+	case ToNegativeInf:
+		roundUp = neg && (last.Sign() != 0 || sticky)
+	case ToNearestEven:
+		roundUp = last.Cmp(fiveInt) > 0 || (last.Cmp(fiveInt) == 0 && (sticky || quo.Bit(0) != 0))
+	case ToNearestOdd:
+		roundUp = last.Cmp(fiveInt) > 0 || (last.Cmp(fiveInt) == 0 && (sticky || quo.Bit(0) == 0))
+	case ToNearestZero:
+		roundUp = last.Cmp(fiveInt) > 0 || (last.Cmp(fiveInt) == 0 && sticky)
+	case ToNearestAway:
+		roundUp = last.Cmp(fiveInt) >= 0
+	default:
+		roundUp = last.Cmp(fiveInt) >= 0
 	}
 
-	// floor for positive numbers, ceil for negative numbers
-	_, m := ret.value.DivMod(ret.value, tenInt, new(big.Int))
-	ret.exp++
-	if ret.value.Sign() < 0 && m.Cmp(zeroInt) != 0 {
-		ret.value.Add(ret.value, oneInt)
+	if roundUp {
+		quo.Add(quo, oneInt)
+	}
+	if neg {
+		quo.Neg(quo)
 	}
 
-	return ret
+	return newDecimal(quo, ret.exp+1)
 }
 
 // Abs ...
 func (d *Decimal) Abs() *Decimal {
-	d2Value := new(big.Int).Abs(d.value)
+	if d.isSmall() && d.coef != math.MinInt64 {
+		c := d.coef
+		if c < 0 {
+			c = -c
+		}
+		return &Decimal{coef: c, exp: d.exp}
+	}
+
 	return &Decimal{
-		value: d2Value,
-		exp:   d.exp,
+		big: new(big.Int).Abs(d.toBig()),
+		exp: d.exp,
 	}
 }
 
 // Cmp ...
 func (d *Decimal) Cmp(d2 *Decimal) int {
 	if d.exp == d2.exp {
-		return d.value.Cmp(d2.value)
+		if d.isSmall() && d2.isSmall() {
+			switch {
+			case d.coef < d2.coef:
+				return -1
+			case d.coef > d2.coef:
+				return 1
+			default:
+				return 0
+			}
+		}
+		return d.toBig().Cmp(d2.toBig())
 	}
 
 	baseExp := min(d.exp, d2.exp)
 	rd := d.rescale(baseExp)
 	rd2 := d2.rescale(baseExp)
 
-	return rd.value.Cmp(rd2.value)
+	return rd.Cmp(rd2)
 }
 
 func (d *Decimal) rescale(exp int32) *Decimal {
+	if exp == d.exp {
+		return d
+	}
+
+	if d.isSmall() {
+		diff := int64(exp) - int64(d.exp)
+		shift := diff
+		if shift < 0 {
+			shift = -shift
+		}
+
+		if shift < int64(len(pow10)) {
+			scale := pow10[shift]
+			switch {
+			case exp > d.exp:
+				return &Decimal{coef: d.coef / scale, exp: exp}
+			default:
+				if product, ok := mulInt64(d.coef, scale); ok {
+					return &Decimal{coef: product, exp: exp}
+				}
+			}
+		}
+	}
+
 	// must convert exps to float64 before - to prevent overflow
 	diff := math.Abs(float64(exp) - float64(d.exp))
-	value := new(big.Int).Set(d.value)
+	value := new(big.Int).Set(d.toBig())
 
 	expScale := new(big.Int).Exp(tenInt, big.NewInt(int64(diff)), nil)
 	if exp > d.exp {
@@ -151,17 +284,17 @@ func (d *Decimal) rescale(exp int32) *Decimal {
 	}
 
 	return &Decimal{
-		value: value,
-		exp:   exp,
+		big: value,
+		exp: exp,
 	}
 }
 
 func (d Decimal) string(trimTrailingZeros bool) string {
 	if d.exp >= 0 {
-		return d.rescale(0).value.String()
+		return (&d).rescale(0).toBig().String()
 	}
 
-	abs := new(big.Int).Abs(d.value)
+	abs := new(big.Int).Abs(d.toBig())
 	str := abs.String()
 
 	var intPart, fractionalPart string
@@ -194,13 +327,48 @@ func (d Decimal) string(trimTrailingZeros bool) string {
 		number += "." + fractionalPart
 	}
 
-	if d.value.Sign() < 0 {
+	if d.sign() < 0 {
 		return "-" + number
 	}
 
 	return number
 }
 
+// addInt64 adds a and b, reporting via ok whether the sum fits in an
+// int64.
+func addInt64(a, b int64) (sum int64, ok bool) {
+	s, _ := bits.Add64(uint64(a), uint64(b), 0)
+	sum = int64(s)
+	sameSign := (a < 0) == (b < 0)
+	return sum, !sameSign || (sum < 0) == (a < 0)
+}
+
+// subInt64 subtracts b from a, reporting via ok whether the difference
+// fits in an int64.
+func subInt64(a, b int64) (diff int64, ok bool) {
+	s, _ := bits.Sub64(uint64(a), uint64(b), 0)
+	diff = int64(s)
+	diffSign := (a < 0) != (b < 0)
+	return diff, !diffSign || (diff < 0) == (a < 0)
+}
+
+// mulInt64 multiplies a and b, reporting via ok whether the product
+// fits in an int64.
+func mulInt64(a, b int64) (product int64, ok bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if (a == math.MinInt64 && b == -1) || (b == math.MinInt64 && a == -1) {
+		return 0, false
+	}
+
+	p := a * b
+	if p/b != a {
+		return 0, false
+	}
+	return p, true
+}
+
 func min(x, y int32) int32 {
 	if x >= y {
 		return y
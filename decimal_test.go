@@ -0,0 +1,118 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddSubMulBasic(t *testing.T) {
+	a, b := New(123, -2), New(77, -2) // 1.23, 0.77
+	if got := a.Add(b).string(false); got != "2.00" {
+		t.Errorf("1.23+0.77 = %q, want 2.00", got)
+	}
+	if got := a.Sub(b).string(false); got != "0.46" {
+		t.Errorf("1.23-0.77 = %q, want 0.46", got)
+	}
+	if got := a.Mul(b).string(false); got != "0.9471" {
+		t.Errorf("1.23*0.77 = %q, want 0.9471", got)
+	}
+}
+
+func TestAddStaysOnFastPathWhenItFits(t *testing.T) {
+	sum := New(1, 0).Add(New(2, 0))
+	if !sum.isSmall() {
+		t.Error("1+2 should stay on the int64 fast path")
+	}
+}
+
+func TestAddPromotesOnOverflow(t *testing.T) {
+	max := New(math.MaxInt64, 0)
+	sum := max.Add(New(1, 0))
+	if sum.isSmall() {
+		t.Fatal("MaxInt64+1 should promote to big.Int")
+	}
+	if got := sum.string(false); got != "9223372036854775808" {
+		t.Errorf("MaxInt64+1 = %q, want 9223372036854775808", got)
+	}
+}
+
+func TestSubPromotesOnOverflow(t *testing.T) {
+	min := New(math.MinInt64, 0)
+	diff := min.Sub(New(1, 0))
+	if diff.isSmall() {
+		t.Fatal("MinInt64-1 should promote to big.Int")
+	}
+	if got := diff.string(false); got != "-9223372036854775809" {
+		t.Errorf("MinInt64-1 = %q, want -9223372036854775809", got)
+	}
+}
+
+func TestMulPromotesOnOverflow(t *testing.T) {
+	product := New(math.MaxInt64, 0).Mul(New(2, 0))
+	if product.isSmall() {
+		t.Fatal("MaxInt64*2 should promote to big.Int")
+	}
+	if got := product.string(false); got != "18446744073709551614" {
+		t.Errorf("MaxInt64*2 = %q, want 18446744073709551614", got)
+	}
+}
+
+func TestMulStaysOnFastPathWhenItFits(t *testing.T) {
+	product := New(1000, 0).Mul(New(2000, 0))
+	if !product.isSmall() {
+		t.Error("1000*2000 should stay on the int64 fast path")
+	}
+	if got := product.string(false); got != "2000000" {
+		t.Errorf("1000*2000 = %q, want 2000000", got)
+	}
+}
+
+func TestAbsMinInt64Promotes(t *testing.T) {
+	abs := New(math.MinInt64, 0).Abs()
+	if abs.isSmall() {
+		t.Fatal("Abs(MinInt64) should promote to big.Int since -MinInt64 overflows int64")
+	}
+	if got := abs.string(false); got != "9223372036854775808" {
+		t.Errorf("Abs(MinInt64) = %q, want 9223372036854775808", got)
+	}
+}
+
+func TestAbsStaysOnFastPath(t *testing.T) {
+	abs := New(-5, -1).Abs()
+	if !abs.isSmall() {
+		t.Error("Abs(-0.5) should stay on the int64 fast path")
+	}
+	if got := abs.string(false); got != "0.5" {
+		t.Errorf("Abs(-0.5) = %q, want 0.5", got)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	a, b := New(100, -2), New(99, -2)
+	if a.Cmp(b) <= 0 {
+		t.Errorf("1.00 should compare greater than 0.99")
+	}
+	if b.Cmp(a) >= 0 {
+		t.Errorf("0.99 should compare less than 1.00")
+	}
+	if a.Cmp(New(1, 0)) != 0 {
+		t.Errorf("1.00 should compare equal to 1 across differing exponents")
+	}
+}
+
+func TestRescaleFastPathStaysSmall(t *testing.T) {
+	r := New(123, 0).rescale(-3)
+	if !r.isSmall() {
+		t.Error("rescaling a small value within pow10 range should stay on the fast path")
+	}
+	if got := r.string(false); got != "123.000" {
+		t.Errorf("rescale(-3) = %q, want 123.000", got)
+	}
+}
+
+func TestRescaleTruncatesOnDivide(t *testing.T) {
+	r := New(12345, -3).rescale(-1) // 12.345 -> 12.3
+	if got := r.string(false); got != "12.3" {
+		t.Errorf("rescale(-1) = %q, want 12.3", got)
+	}
+}
@@ -0,0 +1,364 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Pattern describes a CLDR-style number pattern such as "#,##0.00" or
+// "#,##0.00;(#,##0.00)", as parsed by ParsePattern.
+type Pattern struct {
+	MinIntegerDigits      int32
+	MaxFractionDigits     int32
+	MinFractionDigits     int32
+	GroupingSize          int32
+	SecondaryGroupingSize int32
+
+	PositivePrefix string
+	PositiveSuffix string
+	NegativePrefix string
+	NegativeSuffix string
+
+	// RoundingIncrement, if set, rounds the value to the nearest
+	// multiple of this Decimal before formatting (e.g. 0.05 for
+	// nickel rounding).
+	RoundingIncrement *Decimal
+
+	Percent  bool
+	Permille bool
+
+	Scientific     bool
+	ExponentDigits int32
+
+	HasPadding bool
+	PadChar    rune
+	PadWidth   int32
+	// PadBeforeNumber reports whether the pad escape (`*c`) occurred
+	// before the number template (pad inserted between the prefix and
+	// the number) as opposed to after it (pad inserted between the
+	// number and the suffix).
+	PadBeforeNumber bool
+}
+
+// ParsePattern parses a CLDR-style number pattern into a Pattern. A
+// pattern may specify a negative subpattern after a ';', otherwise the
+// negative form defaults to a literal "-" prefixed to the positive form.
+func ParsePattern(s string) (*Pattern, error) {
+	parts := strings.SplitN(s, ";", 2)
+
+	p, err := parseSubpattern(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) == 2 {
+		neg, err := parseSubpattern(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		p.NegativePrefix = neg.PositivePrefix
+		p.NegativeSuffix = neg.PositiveSuffix
+	} else {
+		p.NegativePrefix = "-" + p.PositivePrefix
+		p.NegativeSuffix = p.PositiveSuffix
+	}
+
+	return p, nil
+}
+
+const numberTemplateChars = "#0,.E"
+
+func parseSubpattern(raw string) (*Pattern, error) {
+	runes := []rune(raw)
+
+	start, end := -1, -1
+	padIdx := -1
+	for i, r := range runes {
+		if r == '*' {
+			padIdx = i
+			continue
+		}
+		if strings.ContainsRune(numberTemplateChars, r) {
+			if start == -1 {
+				start = i
+			}
+			end = i
+		}
+	}
+	if start == -1 {
+		return nil, fmt.Errorf("decimal: invalid pattern %q: no digit placeholders", raw)
+	}
+
+	p := &Pattern{}
+
+	prefixRunes := runes[:start]
+	suffixRunes := runes[end+1:]
+
+	if padIdx >= 0 && padIdx+1 < len(runes) {
+		p.HasPadding = true
+		p.PadChar = runes[padIdx+1]
+		p.PadWidth = int32(len(runes) - 2)
+		if padIdx < start {
+			p.PadBeforeNumber = true
+			prefixRunes = append(append([]rune{}, runes[:padIdx]...), runes[padIdx+2:start]...)
+		} else {
+			suffixRunes = append(append([]rune{}, runes[end+1:padIdx]...), runes[padIdx+2:]...)
+		}
+	}
+
+	p.PositivePrefix = string(prefixRunes)
+	p.PositiveSuffix = string(suffixRunes)
+	p.Percent = strings.ContainsRune(p.PositivePrefix+p.PositiveSuffix, '%')
+	p.Permille = strings.ContainsRune(p.PositivePrefix+p.PositiveSuffix, '‰')
+
+	numberPart := string(runes[start : end+1])
+
+	if idx := strings.IndexByte(numberPart, 'E'); idx >= 0 {
+		p.Scientific = true
+		p.ExponentDigits = int32(len(numberPart) - idx - 1)
+		numberPart = numberPart[:idx]
+	}
+
+	intPart, fracPart := numberPart, ""
+	if idx := strings.IndexByte(numberPart, '.'); idx >= 0 {
+		intPart = numberPart[:idx]
+		fracPart = numberPart[idx+1:]
+	}
+
+	groups := strings.Split(intPart, ",")
+	last := groups[len(groups)-1]
+	for _, r := range last {
+		if r == '0' {
+			p.MinIntegerDigits++
+		}
+	}
+	if len(groups) > 1 {
+		p.GroupingSize = int32(len(last))
+	}
+	if len(groups) > 2 {
+		p.SecondaryGroupingSize = int32(len(groups[len(groups)-2]))
+	}
+
+	for _, r := range fracPart {
+		switch r {
+		case '0':
+			p.MinFractionDigits++
+			p.MaxFractionDigits++
+		case '#':
+			p.MaxFractionDigits++
+		}
+	}
+
+	if strings.ContainsAny(fracPart, "123456789") {
+		digits := strings.ReplaceAll(fracPart, "#", "0")
+		n, ok := new(big.Int).SetString(digits, 10)
+		if !ok {
+			return nil, fmt.Errorf("decimal: invalid rounding increment in pattern %q", raw)
+		}
+		p.RoundingIncrement = newDecimal(n, -int32(len(digits)))
+	}
+
+	return p, nil
+}
+
+// Format renders d according to p: applying the percent/permille
+// multiplier and rounding increment, then grouping and padding the
+// result with p's prefixes and suffixes.
+func (d *Decimal) Format(p *Pattern) string {
+	v := d
+	switch {
+	case p.Percent:
+		v = v.Mul(New(100, 0))
+	case p.Permille:
+		v = v.Mul(New(1000, 0))
+	}
+	if p.RoundingIncrement != nil {
+		v = roundToIncrement(v, p.RoundingIncrement)
+	}
+
+	// Scientific notation rounds to a count of significant digits after
+	// normalizing the exponent, not to a fixed number of decimal
+	// places, so it rounds the full-precision digits itself below
+	// rather than via RoundMode.
+	rounded := v
+	if !p.Scientific {
+		rounded = v.RoundMode(p.MaxFractionDigits, ToNearestEven)
+	}
+
+	neg := rounded.sign() < 0
+	abs := new(big.Int).Abs(rounded.toBig())
+	str := abs.String()
+
+	var intDigits, fracDigits string
+	dExp := int(rounded.exp)
+	switch {
+	case dExp >= 0:
+		intDigits = str + strings.Repeat("0", dExp)
+	case -dExp >= len(str):
+		intDigits = "0"
+		fracDigits = strings.Repeat("0", -dExp-len(str)) + str
+	default:
+		intDigits = str[:len(str)+dExp]
+		fracDigits = str[len(str)+dExp:]
+	}
+
+	var number string
+	if p.Scientific {
+		number = toScientific(intDigits, fracDigits, p.ExponentDigits, p.MaxFractionDigits, p.MinFractionDigits)
+	} else {
+		if int32(len(intDigits)) < p.MinIntegerDigits {
+			intDigits = strings.Repeat("0", int(p.MinIntegerDigits)-len(intDigits)) + intDigits
+		}
+		for int32(len(fracDigits)) < p.MinFractionDigits {
+			fracDigits += "0"
+		}
+
+		number = groupDigits(intDigits, p.GroupingSize, p.SecondaryGroupingSize)
+		if len(fracDigits) > 0 {
+			number += "." + fracDigits
+		}
+	}
+
+	prefix, suffix := p.PositivePrefix, p.PositiveSuffix
+	if neg {
+		prefix, suffix = p.NegativePrefix, p.NegativeSuffix
+	}
+
+	if p.HasPadding {
+		fill := padFill(prefix+number+suffix, p.PadChar, p.PadWidth)
+		if p.PadBeforeNumber {
+			return prefix + fill + number + suffix
+		}
+		return prefix + number + fill + suffix
+	}
+
+	return prefix + number + suffix
+}
+
+func groupDigits(digits string, primary, secondary int32) string {
+	if primary <= 0 || int32(len(digits)) <= primary {
+		return digits
+	}
+
+	n := len(digits)
+	head, tail := digits[:n-int(primary)], digits[n-int(primary):]
+
+	size := secondary
+	if size <= 0 {
+		size = primary
+	}
+
+	var groups []string
+	for int32(len(head)) > size {
+		cut := len(head) - int(size)
+		groups = append([]string{head[cut:]}, groups...)
+		head = head[:cut]
+	}
+	if len(head) > 0 {
+		groups = append([]string{head}, groups...)
+	}
+	groups = append(groups, tail)
+
+	return strings.Join(groups, ",")
+}
+
+// toScientific normalizes intDigits+fracDigits to a single leading
+// digit and rounds the mantissa to maxFracDigits significant
+// fractional digits (half away from zero), padding to minFracDigits
+// if shorter.
+func toScientific(intDigits, fracDigits string, exponentDigits, maxFracDigits, minFracDigits int32) string {
+	digits := strings.TrimLeft(intDigits, "0")
+	exp := len(intDigits) - 1
+	if digits == "" {
+		digits = fracDigits
+		exp = -1
+		for i := 0; i < len(digits) && digits[i] == '0'; i++ {
+			exp--
+		}
+		digits = strings.TrimLeft(digits, "0")
+		if digits == "" {
+			digits, exp = "0", 0
+		}
+	} else {
+		digits += fracDigits
+	}
+
+	if sigDigits := int(maxFracDigits) + 1; sigDigits > 0 && len(digits) > sigDigits {
+		digits, exp = roundDigitString(digits, sigDigits, exp)
+	}
+
+	for len(digits) < int(minFracDigits)+1 {
+		digits += "0"
+	}
+
+	mantissa := digits[:1]
+	if len(digits) > 1 {
+		mantissa += "." + digits[1:]
+	}
+
+	expStr := fmt.Sprintf("%d", exp)
+	if exp >= 0 && int32(len(expStr)) < exponentDigits {
+		expStr = strings.Repeat("0", int(exponentDigits)-len(expStr)) + expStr
+	}
+
+	return mantissa + "E" + expStr
+}
+
+// roundDigitString rounds digits to keep significant digits (half
+// away from zero), returning the rounded digits and the exponent
+// adjusted for any carry out of the leading digit (e.g. "996" -> "1",
+// exp+1).
+func roundDigitString(digits string, keep, exp int) (string, int) {
+	kept := digits[:keep]
+	if digits[keep] < '5' {
+		return kept, exp
+	}
+
+	n, _ := new(big.Int).SetString(kept, 10)
+	n.Add(n, oneInt)
+	rounded := n.String()
+
+	switch {
+	case len(rounded) > keep:
+		exp++
+		rounded = rounded[:keep]
+	case len(rounded) < keep:
+		rounded = strings.Repeat("0", keep-len(rounded)) + rounded
+	}
+
+	return rounded, exp
+}
+
+// padFill returns the run of padChar needed to bring content up to
+// width, or "" if content already meets or exceeds it.
+func padFill(content string, padChar rune, width int32) string {
+	n := int32(len([]rune(content)))
+	if n >= width {
+		return ""
+	}
+	return strings.Repeat(string(padChar), int(width-n))
+}
+
+// roundToIncrement rounds d to the nearest multiple of inc, half away
+// from zero.
+func roundToIncrement(d *Decimal, inc *Decimal) *Decimal {
+	baseExp := min(d.exp, inc.exp)
+	rd := d.rescale(baseExp)
+	rinc := inc.rescale(baseExp)
+
+	rat := new(big.Rat).SetFrac(rd.toBig(), rinc.toBig())
+	num, den := rat.Num(), rat.Denom()
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	if twice.Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			quo.Sub(quo, oneInt)
+		} else {
+			quo.Add(quo, oneInt)
+		}
+	}
+
+	return newDecimal(quo, 0).Mul(inc)
+}
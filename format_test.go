@@ -0,0 +1,85 @@
+package decimal
+
+import "testing"
+
+func TestFormatGroupingAndSign(t *testing.T) {
+	p, err := ParsePattern("#,##0.00;(#,##0.00)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := New(123456789, -2).Format(p); got != "1,234,567.89" {
+		t.Errorf("Format(1234567.89) = %q", got)
+	}
+	if got := New(-123456789, -2).Format(p); got != "(1,234,567.89)" {
+		t.Errorf("Format(-1234567.89) = %q", got)
+	}
+}
+
+func TestFormatPercentAndPermille(t *testing.T) {
+	p, err := ParsePattern("0.00%")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := New(5025, -4).Format(p); got != "50.25%" {
+		t.Errorf("Format(0.5025) = %q, want 50.25%%", got)
+	}
+
+	pm, err := ParsePattern("0.0‰")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := New(5, -3).Format(pm); got != "5.0‰" {
+		t.Errorf("Format(0.005) = %q, want 5.0‰", got)
+	}
+}
+
+func TestFormatRoundingIncrement(t *testing.T) {
+	p, err := ParsePattern("#,##0.05")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := New(103, -2).Format(p); got != "1.05" {
+		t.Errorf("Format(1.03) = %q, want 1.05 (nearest nickel)", got)
+	}
+}
+
+func TestFormatPadding(t *testing.T) {
+	p, err := ParsePattern("*x#,##0.00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.PadBeforeNumber {
+		t.Fatal("expected PadBeforeNumber for a leading pad escape")
+	}
+	if got := New(500, -2).Format(p); got != "xxxx5.00" {
+		t.Errorf("Format(5.00) = %q, want %q", got, "xxxx5.00")
+	}
+
+	suffixPad, err := ParsePattern("#,##0.00*x$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suffixPad.PadBeforeNumber {
+		t.Fatal("expected PadBeforeNumber to be false for a trailing pad escape")
+	}
+	if got := New(500, -2).Format(suffixPad); got[:4] != "5.00" {
+		t.Errorf("Format(5.00) = %q, want fill inserted after the number", got)
+	}
+}
+
+func TestFormatScientific(t *testing.T) {
+	p, err := ParsePattern("0.00E00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := New(123456, -2).Format(p); got != "1.23E03" {
+		t.Errorf("Format(1234.56) = %q, want 1.23E03", got)
+	}
+}
+
+func TestParsePatternRejectsMissingDigits(t *testing.T) {
+	if _, err := ParsePattern("abc"); err == nil {
+		t.Fatal("expected an error for a pattern with no digit placeholders")
+	}
+}
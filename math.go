@@ -0,0 +1,279 @@
+package decimal
+
+import (
+	"math/big"
+)
+
+// Div divides d by d2, producing a quotient with exactly precision
+// fractional digits. The remainder is resolved into the last digit
+// according to mode.
+func (d *Decimal) Div(d2 *Decimal, precision int32, mode RoundingMode) *Decimal {
+	if d2.sign() == 0 {
+		panic("decimal: division by zero")
+	}
+
+	// Align d and d2 to exact integers num/den such that num/den equals
+	// d/d2 * 10^precision, rather than rescaling d itself: rescale would
+	// truncate d's digits toward zero whenever d2's exponent exceeds
+	// d's by more than precision, discarding precision that mode should
+	// have gotten to round on.
+	shift := int64(d.exp) - int64(d2.exp) + int64(precision)
+
+	num, den := d.toBig(), d2.toBig()
+	if shift >= 0 {
+		num = new(big.Int).Mul(num, new(big.Int).Exp(tenInt, big.NewInt(shift), nil))
+	} else {
+		den = new(big.Int).Mul(den, new(big.Int).Exp(tenInt, big.NewInt(-shift), nil))
+	}
+
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		neg := (num.Sign() < 0) != (den.Sign() < 0)
+
+		absR := new(big.Int).Abs(r)
+		absDivisor := new(big.Int).Abs(den)
+		twice := new(big.Int).Lsh(absR, 1)
+		cmp := twice.Cmp(absDivisor)
+
+		absQ := new(big.Int).Abs(q)
+		roundUp := roundUpFromRemainder(mode, cmp, neg, absQ.Bit(0) != 0)
+		if roundUp {
+			if neg {
+				q.Sub(q, oneInt)
+			} else {
+				q.Add(q, oneInt)
+			}
+		}
+	}
+
+	return newDecimal(q, -precision)
+}
+
+// DivMod returns the Euclidean quotient and remainder of d / d2: quot is
+// an integer-valued Decimal and rem shares d's and d2's common scale,
+// with d == quot*d2 + rem and rem never negative.
+func (d *Decimal) DivMod(d2 *Decimal) (quot, rem *Decimal) {
+	if d2.sign() == 0 {
+		panic("decimal: division by zero")
+	}
+
+	baseExp := min(d.exp, d2.exp)
+	rd := d.rescale(baseExp)
+	rd2 := d2.rescale(baseExp)
+
+	q, r := new(big.Int), new(big.Int)
+	q.DivMod(rd.toBig(), rd2.toBig(), r)
+
+	return newDecimal(q, 0), newDecimal(r, baseExp)
+}
+
+// Mod returns the Euclidean remainder of d / d2.
+func (d *Decimal) Mod(d2 *Decimal) *Decimal {
+	_, rem := d.DivMod(d2)
+	return rem
+}
+
+// roundUpFromRemainder decides, for a division with a nonzero
+// remainder, whether the truncated quotient should move one unit away
+// from zero. cmp is the comparison of 2*|remainder| against |divisor|;
+// oddQuotient reports whether the truncated quotient's magnitude is odd.
+func roundUpFromRemainder(mode RoundingMode, cmp int, neg, oddQuotient bool) bool {
+	switch mode {
+	case ToZero:
+		return false
+	case AwayFromZero:
+		return true
+	case ToPositiveInf:
+		return !neg
+	case ToNegativeInf:
+		return neg
+	case ToNearestEven:
+		return cmp > 0 || (cmp == 0 && oddQuotient)
+	case ToNearestOdd:
+		return cmp > 0 || (cmp == 0 && !oddQuotient)
+	case ToNearestZero:
+		return cmp > 0
+	case ToNearestAway:
+		return cmp >= 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// Pow raises d to the power n. Integer exponents are computed exactly
+// via square-and-multiply (negative integer exponents fall back to Div
+// for the final inversion); non-integer exponents are computed via
+// series expansion to the requested precision.
+func (d *Decimal) Pow(n *Decimal, precision int32) *Decimal {
+	if exp, ok := integerValue(n); ok {
+		return d.powInt(exp, precision)
+	}
+	return d.powSeries(n, precision)
+}
+
+func integerValue(d *Decimal) (int64, bool) {
+	if d.exp >= 0 {
+		v := new(big.Int).Mul(d.toBig(), new(big.Int).Exp(tenInt, big.NewInt(int64(d.exp)), nil))
+		return v.Int64(), true
+	}
+
+	div := new(big.Int).Exp(tenInt, big.NewInt(int64(-d.exp)), nil)
+	q, r := new(big.Int).QuoRem(d.toBig(), div, new(big.Int))
+	if r.Sign() != 0 {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+func (d *Decimal) powInt(exp int64, precision int32) *Decimal {
+	neg := exp < 0
+	if neg {
+		exp = -exp
+	}
+
+	result, base := New(1, 0), d
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.Mul(base)
+		}
+		base = base.Mul(base)
+		exp >>= 1
+	}
+
+	if neg {
+		return New(1, 0).Div(result, precision, ToNearestEven)
+	}
+	return result
+}
+
+// powSeries computes d^n for a non-integer n as exp(n*ln(d)), using
+// Taylor series over big.Rat for exp and ln.
+func (d *Decimal) powSeries(n *Decimal, precision int32) *Decimal {
+	if d.sign() <= 0 {
+		panic("decimal: non-integer power requires a positive base")
+	}
+
+	const guardDigits = 10
+	workingPrecision := precision + guardDigits
+
+	lnBase := ratLn(toRat(d), workingPrecision)
+	product := new(big.Rat).Mul(lnBase, toRat(n))
+
+	return ratToDecimal(ratExp(product, workingPrecision), precision)
+}
+
+// Sqrt computes the square root of d to the requested number of
+// fractional digits using Newton-Raphson iteration on the big.Int
+// coefficient obtained by rescaling d to -2*precision.
+func (d *Decimal) Sqrt(precision int32) *Decimal {
+	if d.sign() < 0 {
+		panic("decimal: square root of negative number")
+	}
+
+	v := d.rescale(-2 * precision).toBig()
+	if v.Sign() == 0 {
+		return &Decimal{coef: 0, exp: -precision}
+	}
+
+	x := new(big.Int).Sqrt(v)
+	if x.Sign() == 0 {
+		x = big.NewInt(1)
+	}
+
+	for i := 0; i < 64; i++ {
+		next := new(big.Int).Quo(v, x)
+		next.Add(next, x)
+		next.Quo(next, big.NewInt(2))
+		if next.Cmp(x) == 0 {
+			break
+		}
+		x = next
+	}
+
+	return newDecimal(x, -precision)
+}
+
+func toRat(d *Decimal) *big.Rat {
+	if d.exp >= 0 {
+		v := new(big.Int).Mul(d.toBig(), new(big.Int).Exp(tenInt, big.NewInt(int64(d.exp)), nil))
+		return new(big.Rat).SetInt(v)
+	}
+
+	denom := new(big.Int).Exp(tenInt, big.NewInt(int64(-d.exp)), nil)
+	return new(big.Rat).SetFrac(d.toBig(), denom)
+}
+
+func ratToDecimal(r *big.Rat, precision int32) *Decimal {
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(tenInt, big.NewInt(int64(precision)), nil))
+	scaled := new(big.Rat).Mul(r, scale)
+
+	num, den := scaled.Num(), scaled.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	if twice.Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			q.Sub(q, oneInt)
+		} else {
+			q.Add(q, oneInt)
+		}
+	}
+
+	return newDecimal(q, -precision)
+}
+
+func ratEpsilon(precision int32) *big.Rat {
+	return new(big.Rat).SetFrac(oneInt, new(big.Int).Exp(tenInt, big.NewInt(int64(precision)), nil))
+}
+
+func ratAbs(r *big.Rat) *big.Rat {
+	if r.Sign() < 0 {
+		return new(big.Rat).Neg(r)
+	}
+	return r
+}
+
+// ratExp computes exp(x) via its Taylor series, iterating until the
+// next term is smaller than the requested precision allows.
+func ratExp(x *big.Rat, precision int32) *big.Rat {
+	eps := ratEpsilon(precision)
+	sum := big.NewRat(1, 1)
+	term := big.NewRat(1, 1)
+
+	for k := int64(1); k <= 1000; k++ {
+		term = new(big.Rat).Mul(term, x)
+		term = new(big.Rat).Quo(term, new(big.Rat).SetInt64(k))
+		sum.Add(sum, term)
+		if ratAbs(term).Cmp(eps) < 0 {
+			break
+		}
+	}
+
+	return sum
+}
+
+// ratLn computes ln(x) for x > 0 via the series
+// ln(x) = 2*atanh((x-1)/(x+1)), which converges for all x > 0.
+func ratLn(x *big.Rat, precision int32) *big.Rat {
+	if x.Sign() <= 0 {
+		panic("decimal: ln of non-positive number")
+	}
+
+	one := big.NewRat(1, 1)
+	y := new(big.Rat).Quo(new(big.Rat).Sub(x, one), new(big.Rat).Add(x, one))
+	y2 := new(big.Rat).Mul(y, y)
+
+	eps := ratEpsilon(precision)
+	term := new(big.Rat).Set(y)
+	sum := new(big.Rat).Set(term)
+
+	for k := int64(1); k <= 1000; k++ {
+		term = new(big.Rat).Mul(term, y2)
+		add := new(big.Rat).Quo(term, new(big.Rat).SetInt64(2*k+1))
+		sum.Add(sum, add)
+		if ratAbs(add).Cmp(eps) < 0 {
+			break
+		}
+	}
+
+	return new(big.Rat).Mul(sum, big.NewRat(2, 1))
+}
@@ -0,0 +1,104 @@
+package decimal
+
+import "testing"
+
+func TestDiv(t *testing.T) {
+	ten, three := New(10, 0), New(3, 0)
+	if got := ten.Div(three, 5, ToNearestEven).string(false); got != "3.33333" {
+		t.Errorf("10/3 to 5 places = %q, want 3.33333", got)
+	}
+	if got := New(1, 0).Div(New(4, 0), 2, ToNearestEven).string(false); got != "0.25" {
+		t.Errorf("1/4 to 2 places = %q, want 0.25", got)
+	}
+}
+
+func TestDivMismatchedExponents(t *testing.T) {
+	// 1.2349 / 100 to 5 places: the divisor's exponent (2) exceeds the
+	// dividend's (-4) by more than precision, so a naive rescale of the
+	// dividend to d2.exp-precision would truncate digits before the
+	// division ever ran, making RoundingMode a no-op.
+	n, d2 := New(12349, -4), New(1, 2)
+
+	if got := n.Div(d2, 5, ToZero).string(false); got != "0.01234" {
+		t.Errorf("1.2349/100 to 5 places, ToZero = %q, want 0.01234", got)
+	}
+	if got := n.Div(d2, 5, ToNearestAway).string(false); got != "0.01235" {
+		t.Errorf("1.2349/100 to 5 places, ToNearestAway = %q, want 0.01235", got)
+	}
+}
+
+func TestDivByZeroPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "decimal: division by zero" {
+			t.Fatalf("unexpected panic value: %v", r)
+		}
+	}()
+	New(1, 0).Div(New(0, 0), 2, ToNearestEven)
+}
+
+func TestDivModByZeroPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "decimal: division by zero" {
+			t.Fatalf("unexpected panic value: %v", r)
+		}
+	}()
+	New(1, 0).DivMod(New(0, 0))
+}
+
+func TestDivModAndMod(t *testing.T) {
+	ten, three := New(10, 0), New(3, 0)
+
+	q, r := ten.DivMod(three)
+	if q.string(false) != "3" || r.string(false) != "1" {
+		t.Errorf("10 DivMod 3 = (%s, %s), want (3, 1)", q.string(false), r.string(false))
+	}
+
+	negTen := New(-10, 0)
+	q2, r2 := negTen.DivMod(three)
+	if q2.string(false) != "-4" || r2.string(false) != "2" {
+		t.Errorf("-10 DivMod 3 = (%s, %s), want (-4, 2) (Euclidean remainder >= 0)", q2.string(false), r2.string(false))
+	}
+
+	if got := ten.Mod(three).string(false); got != "1" {
+		t.Errorf("10 Mod 3 = %q, want 1", got)
+	}
+}
+
+func TestPowIntegerExponents(t *testing.T) {
+	two := New(2, 0)
+	if got := two.Pow(New(10, 0), 0).string(false); got != "1024" {
+		t.Errorf("2^10 = %q, want 1024", got)
+	}
+	if got := two.Pow(New(0, 0), 0).string(false); got != "1" {
+		t.Errorf("2^0 = %q, want 1", got)
+	}
+	if got := two.Pow(New(-1, 0), 4).string(false); got != "0.5000" {
+		t.Errorf("2^-1 to 4 places = %q, want 0.5000", got)
+	}
+}
+
+func TestPowNonIntegerExponent(t *testing.T) {
+	nine := New(9, 0)
+	got := nine.Pow(New(5, -1), 6).string(false) // 9^0.5 == 3
+	if got != "3.000000" {
+		t.Errorf("9^0.5 to 6 places = %q, want 3.000000", got)
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	if got := New(4, 0).Sqrt(5).string(false); got != "2.00000" {
+		t.Errorf("sqrt(4) to 5 places = %q, want 2.00000", got)
+	}
+	if got := New(2, 0).Sqrt(10).string(false); got != "1.4142135623" {
+		t.Errorf("sqrt(2) to 10 places = %q, want 1.4142135623", got)
+	}
+}
+
+func TestSqrtNegativePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "decimal: square root of negative number" {
+			t.Fatalf("unexpected panic value: %v", r)
+		}
+	}()
+	New(-1, 0).Sqrt(2)
+}
@@ -0,0 +1,30 @@
+package decimal
+
+// RoundingMode selects how RoundMode resolves the digit discarded when
+// rounding to a given number of places. The set follows the CLDR
+// rounding-mode taxonomy.
+type RoundingMode int8
+
+const (
+	// ToNearestEven rounds to the nearest value; on a tie, rounds to the
+	// neighbor whose last digit is even (banker's rounding).
+	ToNearestEven RoundingMode = iota
+	// ToNearestAway rounds to the nearest value; on a tie, rounds away
+	// from zero.
+	ToNearestAway
+	// ToNearestZero rounds to the nearest value; on a tie, rounds toward
+	// zero.
+	ToNearestZero
+	// ToZero truncates the value, discarding any digits beyond places.
+	ToZero
+	// AwayFromZero rounds away from zero whenever a nonzero digit is
+	// discarded.
+	AwayFromZero
+	// ToPositiveInf rounds toward positive infinity (ceiling).
+	ToPositiveInf
+	// ToNegativeInf rounds toward negative infinity (floor).
+	ToNegativeInf
+	// ToNearestOdd rounds to the nearest value; on a tie, rounds to the
+	// neighbor whose last digit is odd.
+	ToNearestOdd
+)
@@ -0,0 +1,52 @@
+package decimal
+
+import "testing"
+
+func TestRoundModeTieBreaks(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string // d, exp encoded as coef/exp pairs below
+		coef  int64
+		exp   int32
+		place int32
+		mode  RoundingMode
+		want  string
+	}{
+		{"even tie rounds down to even", "2.125", 2125, -3, 2, ToNearestEven, "2.12"},
+		{"even tie rounds up to even", "2.135", 2135, -3, 2, ToNearestEven, "2.14"},
+		{"odd tie rounds up to odd", "2.125", 2125, -3, 2, ToNearestOdd, "2.13"},
+		{"odd tie rounds down to odd", "2.135", 2135, -3, 2, ToNearestOdd, "2.13"},
+		{"zero tie rounds toward zero", "2.125", 2125, -3, 2, ToNearestZero, "2.12"},
+		{"zero tie negative rounds toward zero", "-2.125", -2125, -3, 2, ToNearestZero, "-2.12"},
+		{"away tie rounds away from zero", "2.125", 2125, -3, 2, ToNearestAway, "2.13"},
+		{"away tie negative rounds away from zero", "-2.125", -2125, -3, 2, ToNearestAway, "-2.13"},
+		{"to zero truncates", "2.129", 2129, -3, 2, ToZero, "2.12"},
+		{"to zero truncates negative", "-2.129", -2129, -3, 2, ToZero, "-2.12"},
+		{"away from zero always rounds up in magnitude", "2.121", 2121, -3, 2, AwayFromZero, "2.13"},
+		{"positive inf rounds up for positive", "2.121", 2121, -3, 2, ToPositiveInf, "2.13"},
+		{"positive inf truncates for negative", "-2.121", -2121, -3, 2, ToPositiveInf, "-2.12"},
+		{"negative inf truncates for positive", "2.121", 2121, -3, 2, ToNegativeInf, "2.12"},
+		{"negative inf rounds down for negative", "-2.121", -2121, -3, 2, ToNegativeInf, "-2.13"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := New(c.coef, c.exp)
+			got := d.RoundMode(c.place, c.mode).string(false)
+			if got != c.want {
+				t.Errorf("%s.RoundMode(%d, mode=%d) = %q, want %q", c.value, c.place, c.mode, got, c.want)
+			}
+		})
+	}
+}
+
+func TestRoundShortcuts(t *testing.T) {
+	half := New(125, -2) // 1.25
+
+	if got := half.Round(1).string(false); got != "1.3" {
+		t.Errorf("Round(1) = %q, want %q", got, "1.3")
+	}
+	if got := half.RoundBank(1).string(false); got != "1.2" {
+		t.Errorf("RoundBank(1) = %q, want %q", got, "1.2")
+	}
+}